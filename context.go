@@ -0,0 +1,220 @@
+package fibonacci
+
+import (
+	"math/big"
+	"sync"
+)
+
+// Context holds the big.Int scratch storage used by the Fibonacci algorithms
+// so that repeated calls, even for huge n, reuse the same backing storage
+// instead of allocating fresh big.Ints (and the intermediate t1, t2, f3
+// values inside their loops) on every call. A Context is not safe for
+// concurrent use; give each goroutine its own, or draw one from a sync.Pool
+// with GetContext/PutContext.
+type Context struct {
+	// fibSeries scratch
+	sa, sb *big.Int
+
+	// fibBlenkinsop scratch
+	bf1, bf2, bf3 *big.Int
+
+	// fibTakahashi scratch
+	tf, tl, tsign, tt1, tt2 *big.Int
+
+	// fibFastDoubling scratch
+	da, db, ds, dc, dd *big.Int
+}
+
+// NewContext returns a Context with its scratch storage allocated and ready
+// for use.
+func NewContext() *Context {
+	return &Context{
+		sa: new(big.Int), sb: new(big.Int),
+
+		bf1: new(big.Int), bf2: new(big.Int), bf3: new(big.Int),
+
+		tf: new(big.Int), tl: new(big.Int), tsign: new(big.Int),
+		tt1: new(big.Int), tt2: new(big.Int),
+
+		da: new(big.Int), db: new(big.Int), ds: new(big.Int),
+		dc: new(big.Int), dd: new(big.Int),
+	}
+}
+
+// Fibonacci writes the Nth Fibonacci number into dst, selecting an algorithm
+// via the same registry (see registry.go) that the package-level Fibonacci
+// uses, and returns dst. A selection that names one of the built-in
+// algorithms reuses c's scratch storage for it, the same as calling the
+// matching method below directly; a Register override naming anything else
+// falls back to calling its Fn and copying the result into dst.
+func (c *Context) Fibonacci(n int, dst *big.Int) *big.Int {
+	switch {
+	case n < 1:
+		return dst.SetInt64(0)
+	case n < len(fibonacciTable):
+		return dst.SetInt64(fibonacciTable[n])
+	}
+
+	a := algorithmEntryFor(n)
+	switch a.Name {
+	case "series":
+		return c.FibonacciSeries(n, dst)
+	case "blenkinsop":
+		return c.FibonacciBlenkinsop(n, dst)
+	case "takahashi":
+		return c.FibonacciTakahashi(n, dst)
+	case "fastdoubling":
+		return c.FibonacciFastDoubling(n, dst)
+	default:
+		return dst.Set(a.Fn(n))
+	}
+}
+
+// FibonacciSeries writes the Nth Fibonacci number into dst using fibSeries's
+// algorithm, reusing c's scratch storage, and returns dst.
+func (c *Context) FibonacciSeries(n int, dst *big.Int) *big.Int {
+	if n < 1 {
+		return dst.SetInt64(0)
+	}
+
+	a := c.sa.SetInt64(0)
+	b := c.sb.SetInt64(1)
+
+	for i := 0; i < n; i++ {
+		a, b = b, addMaybeHalf(a, a, b)
+	}
+
+	return dst.Set(a)
+}
+
+// FibonacciBlenkinsop writes the Nth Fibonacci number into dst using
+// fibBlenkinsop's algorithm, reusing c's scratch storage, and returns dst.
+func (c *Context) FibonacciBlenkinsop(n int, dst *big.Int) *big.Int {
+	if n < 1 {
+		return dst.SetInt64(0)
+	}
+
+	h := uint(log2(n))
+
+	f1 := c.bf1.SetInt64(0)
+	f2 := c.bf2.SetInt64(1)
+	f3 := c.bf3
+
+	for ; h > 0; h-- {
+		addMaybeHalf(f3, f1, f2)
+		if (n>>(h-1))&1 == 1 {
+			f1.Add(f1, f3).Mul(f1, f2)
+			f2.Mul(f2, f2).Add(f2, f3.Mul(f3, f3))
+		} else {
+			f3.Add(f1, f3).Mul(f3, f2)
+			f1.Mul(f1, f1).Add(f1, f2.Mul(f2, f2))
+			f2, f3 = f3, f2
+		}
+	}
+
+	return dst.Set(f2)
+}
+
+// FibonacciTakahashi writes the Nth Fibonacci number into dst using
+// fibTakahashi's algorithm, reusing c's scratch storage, and returns dst.
+func (c *Context) FibonacciTakahashi(n int, dst *big.Int) *big.Int {
+	if n <= 0 {
+		return dst.SetInt64(0)
+	}
+	if n <= 2 {
+		return dst.SetInt64(1)
+	}
+
+	f := c.tf.SetInt64(1)
+	l := c.tl.SetInt64(1)
+	sign := c.tsign.SetInt64(-1)
+	t1 := c.tt1.SetInt64(0)
+	t2 := c.tt2.SetInt64(0)
+
+	bits := log2(n)
+	mask := 1 << uint(bits-1)
+
+	for i := 1; i < bits; i++ {
+		t1.Mul(f, f)                    // t1 := f * f
+		addMaybeHalf(f, f, l).Rsh(f, 1) // f = (f + l) >> 1
+		f.Mul(f, f).Lsh(f, 1)           // f = (f*f)<<1 - 3*t1 - 2*sign
+		f.Sub(f, t2.Mul(t1, c3))
+		f.Sub(f, t2.Mul(sign, c2))
+		l.Mul(t1, c5) // l = 5*t1 + 2*sign
+		l.Add(l, t2.Mul(sign, c2))
+
+		sign.SetInt64(1) // sign = 1
+
+		if n&mask != 0 {
+			t1.Set(f)                       //t1 = f
+			addMaybeHalf(f, f, l).Rsh(f, 1) //f = (f + l) >> 1
+			t1.Lsh(t1, 1)                   //l = f + 2*t1
+			l.Add(f, t1)
+			sign.SetInt64(-1) //sign = -1
+		}
+		mask >>= 1
+	}
+
+	if n&mask == 0 {
+		f.Mul(f, l) //f = f * l
+	} else {
+		f.Add(f, l).Rsh(f, 1)    //f = (f + l) >> 1
+		f.Mul(f, l).Sub(f, sign) //f = f*l - sign
+	}
+
+	return dst.Set(f)
+}
+
+// FibonacciFastDoubling writes the Nth Fibonacci number into dst using
+// fibFastDoubling's algorithm, reusing c's scratch storage, and returns dst.
+func (c *Context) FibonacciFastDoubling(n int, dst *big.Int) *big.Int {
+	if n < 1 {
+		return dst.SetInt64(0)
+	}
+
+	bits := log2(n) + 1
+
+	a := c.da.SetInt64(0) // F(m)
+	b := c.db.SetInt64(1) // F(m+1)
+
+	s := c.ds  // scratch
+	cc := c.dc // F(2m)
+	d := c.dd  // F(2m+1)
+
+	for h := bits - 1; h >= 0; h-- {
+		s.Lsh(b, 1).Sub(s, a)
+		cc.Mul(a, s)
+		s.Mul(a, a)
+		d.Mul(b, b)
+		addMaybeHalf(d, d, s)
+
+		if (n>>uint(h))&1 == 1 {
+			a.Set(d)
+			addMaybeHalf(b, cc, d)
+		} else {
+			a.Set(cc)
+			b.Set(d)
+		}
+	}
+
+	return dst.Set(a)
+}
+
+// contextPool lets server workloads borrow a Context instead of allocating
+// one per request.
+var contextPool = sync.Pool{
+	New: func() interface{} { return NewContext() },
+}
+
+// GetContext returns a Context drawn from a shared pool, allocating a new one
+// if the pool is empty. Return it with PutContext when done.
+func GetContext() *Context {
+	return contextPool.Get().(*Context)
+}
+
+// PutContext returns a Context to the shared pool for reuse. Every method on
+// Context overwrites its scratch fields before reading them, so a returned
+// Context does not need to be reset first.
+func PutContext(c *Context) {
+	contextPool.Put(c)
+}
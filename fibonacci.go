@@ -31,6 +31,10 @@ var fibonacciTable = []int64{
 // are used so the 10 millionth value requires about a second to create the result, which
 // has 2,089,877 digits when formatted in decimal. Time measured and algorithm breakpoint
 // determined on 2013 MacBook Pro test system (2.7 GHz Intel Core i7, MacBookPro10,1)
+//
+// The algorithm used for n beyond the static table is chosen by the registry
+// in registry.go, which can be reconfigured with Register; see its init for
+// the measured crossovers (and why fibFastDoubling isn't one of them).
 func Fibonacci(n int) (f *big.Int) {
 	switch {
 	case n < 1:
@@ -40,17 +44,33 @@ func Fibonacci(n int) (f *big.Int) {
 	case n < len(fibonacciTable):
 		f = big.NewInt(fibonacciTable[n])
 
-	// big integer evaluation using algorithims in their most efficient ranges
-	case n <= 100: // Direct series evaluation is fast for small values
-		f = fibSeries(n)
-	case n <= 5504: // Blenkinsop algorithm is faster for values in 100..5504 on test system
-		f = fibBlenkinsop(n)
-	default: // Takahashi algorithm is faster for values > 5504 on test system
-		f = fibTakahashi(n)
+	default:
+		f = algorithmFor(n)(n)
 	}
 	return
 }
 
+// FibonacciSeries returns the Nth Fibonacci number using fibSeries, so callers
+// can select or benchmark this algorithm directly instead of going through
+// Fibonacci's automatic selection.
+func FibonacciSeries(n int) *big.Int {
+	return fibSeries(n)
+}
+
+// FibonacciBlenkinsop returns the Nth Fibonacci number using fibBlenkinsop, so
+// callers can select or benchmark this algorithm directly instead of going
+// through Fibonacci's automatic selection.
+func FibonacciBlenkinsop(n int) *big.Int {
+	return fibBlenkinsop(n)
+}
+
+// FibonacciTakahashi returns the Nth Fibonacci number using fibTakahashi, so
+// callers can select or benchmark this algorithm directly instead of going
+// through Fibonacci's automatic selection.
+func FibonacciTakahashi(n int) *big.Int {
+	return fibTakahashi(n)
+}
+
 func log2(n int) (bits int) {
 	for n>>uint(bits+1) != 0 {
 		bits++
@@ -67,7 +87,7 @@ func fibSeries(n int) *big.Int {
 	b := big.NewInt(1)
 
 	for i := 0; i < n; i++ {
-		a, b = b, a.Add(a, b)
+		a, b = b, addMaybeHalf(a, a, b)
 	}
 
 	return a
@@ -85,7 +105,7 @@ func fibBlenkinsop(n int) *big.Int {
 	f3 := new(big.Int)
 
 	for ; h > 0; h-- {
-		f3.Add(f1, f2)
+		addMaybeHalf(f3, f1, f2)
 		if (n>>(h-1))&1 == 1 {
 			f1.Add(f1, f3).Mul(f1, f2)
 			f2.Mul(f2, f2).Add(f2, f3.Mul(f3, f3))
@@ -121,9 +141,9 @@ func fibTakahashi(n int) *big.Int {
 	t2 := big.NewInt(0)
 
 	for i := 1; i < bits; i++ {
-		t1.Mul(f, f)          // t1 := f * f
-		f.Add(f, l).Rsh(f, 1) // f = (f + l) >> 1
-		f.Mul(f, f).Lsh(f, 1) // f = (f*f)<<1 - 3*t1 - 2*sign
+		t1.Mul(f, f)                    // t1 := f * f
+		addMaybeHalf(f, f, l).Rsh(f, 1) // f = (f + l) >> 1
+		f.Mul(f, f).Lsh(f, 1)           // f = (f*f)<<1 - 3*t1 - 2*sign
 		f.Sub(f, t2.Mul(t1, c3))
 		f.Sub(f, t2.Mul(sign, c2))
 		l.Mul(t1, c5) // l = 5*t1 + 2*sign
@@ -132,9 +152,9 @@ func fibTakahashi(n int) *big.Int {
 		sign.SetInt64(1) // sign = 1
 
 		if n&mask != 0 {
-			t1.Set(f)             //t1 = f
-			f.Add(f, l).Rsh(f, 1) //f = (f + l) >> 1
-			t1.Lsh(t1, 1)         //l = f + 2*t1
+			t1.Set(f)                       //t1 = f
+			addMaybeHalf(f, f, l).Rsh(f, 1) //f = (f + l) >> 1
+			t1.Lsh(t1, 1)                   //l = f + 2*t1
 			l.Add(f, t1)
 			sign.SetInt64(-1) //sign = -1
 		}
@@ -150,22 +170,3 @@ func fibTakahashi(n int) *big.Int {
 
 	return f
 }
-
-// fib(k) returns the kth fibonacci number
-func fibDouble(k int) *big.Int {
-	// http://www.nayuki.io/page/fast-fibonacci-algorithms
-	var a, b, c = big.NewInt(0), big.NewInt(1), new(big.Int)
-	var bit uint64
-	for bit = 1 << 63; bit > 0; bit >>= 1 {
-		// a, b = a*b + a*b - a*a, b*b + a*a
-		c.Mul(a, b).Add(c, c).Sub(c, a.Mul(a, a))
-		b.Add(b.Mul(b, b), a)
-		a.Set(c)
-		if uint64(k)&bit != 0 {
-			c.Add(a, b)
-			a.Set(b)
-			b.Set(c)
-		}
-	}
-	return a
-}
@@ -0,0 +1,123 @@
+package fibonacci
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+// benchSizes are the representative values of n used to compare algorithms
+// and re-derive the crossovers registered for Fibonacci in registry.go's init.
+var benchSizes = []int{100, 1000, 10000, 100000, 1000000}
+
+func BenchmarkFibonacciSeries(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fibSeries(n)
+			}
+		})
+	}
+}
+
+func BenchmarkFibonacciBlenkinsop(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fibBlenkinsop(n)
+			}
+		})
+	}
+}
+
+func BenchmarkFibonacciTakahashi(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fibTakahashi(n)
+			}
+		})
+	}
+}
+
+func BenchmarkFibonacciFastDoubling(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fibFastDoubling(n)
+			}
+		})
+	}
+}
+
+// pisanoModuli range from about 2^10 to about 2^40, all below
+// pisanoModerateBits, and are used to re-measure how PisanoPeriod's
+// trial-division factoring scales with modulus size.
+var pisanoModuli = []int64{1009, 1000003, 1000000007, 999999999989}
+
+// BenchmarkPisanoPeriod times PisanoPeriod across pisanoModuli so
+// pisanoModerateBits can be re-derived on a given machine.
+func BenchmarkPisanoPeriod(b *testing.B) {
+	for _, m := range pisanoModuli {
+		b.Run(strconv.FormatInt(m, 10), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pisanoCacheMu.Lock()
+				delete(pisanoCache, big.NewInt(m).String())
+				pisanoCacheMu.Unlock()
+				PisanoPeriod(big.NewInt(m))
+			}
+		})
+	}
+}
+
+// wordSizes are representative big.Int widths, in big.Words, used to check
+// whether natAddHalf ever beats big.Int's native Add. They are powers of two
+// from 8 to 512 words, covering the widths of Fibonacci(n) for n from about
+// 750 to about 47000; the widths of the other n in benchSizes are not
+// included. As of the last measurement natAddHalf loses at every size here, by
+// 10x-30x, which is why addMaybeHalf doesn't use it.
+var wordSizes = []int{8, 16, 32, 64, 128, 256, 512}
+
+func wordsOfOnes(words int) *big.Int {
+	z := new(big.Int).Lsh(big.NewInt(1), uint(words)*wordBits)
+	return z.Sub(z, big.NewInt(1))
+}
+
+// BenchmarkCrossovers times natAddHalf against big.Int.Add across wordSizes,
+// and logs the narrowest width at which the half-word path wins, if any. If
+// one is ever found, wire natAddHalf back into addMaybeHalf above it.
+func BenchmarkCrossovers(b *testing.B) {
+	crossover := 0
+	for _, words := range wordSizes {
+		x := wordsOfOnes(words)
+		y := wordsOfOnes(words - 1)
+		z := new(big.Int)
+		var addNs, halfNs float64
+
+		b.Run("Add/"+strconv.Itoa(words), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				z.Add(x, y)
+			}
+			addNs = float64(b.Elapsed()) / float64(b.N)
+		})
+		b.Run("AddHalf/"+strconv.Itoa(words), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				natAddHalf(z, x, y)
+			}
+			halfNs = float64(b.Elapsed()) / float64(b.N)
+		})
+		if crossover == 0 && addNs > 0 && halfNs > 0 && halfNs < addNs {
+			crossover = words
+		}
+		b.Run("Mul/"+strconv.Itoa(words), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				z.Mul(x, x)
+			}
+		})
+	}
+	if crossover > 0 {
+		b.Logf("natAddHalf first beats Add at %d words", crossover)
+	} else {
+		b.Logf("natAddHalf did not beat Add at any width in wordSizes")
+	}
+}
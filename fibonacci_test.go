@@ -0,0 +1,183 @@
+package fibonacci
+
+import (
+	"math/big"
+	"testing"
+)
+
+// testSizes brackets the static table and every registry boundary
+// (0/100/101/5504/5505), plus a couple of larger values each algorithm
+// actually gets exercised at.
+var testSizes = []int{0, 1, 2, 3, 50, 91, 92, 93, 100, 101, 5504, 5505, 10000, 50000}
+
+// bruteForceFibonacci computes F(n) by direct recurrence, independent of
+// every algorithm under test, as the reference for correctness checks.
+func bruteForceFibonacci(n int) *big.Int {
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < n; i++ {
+		a, b = b, new(big.Int).Add(a, b)
+	}
+	return a
+}
+
+func TestFibonacci(t *testing.T) {
+	for _, n := range testSizes {
+		want := bruteForceFibonacci(n)
+		if got := Fibonacci(n); got.Cmp(want) != 0 {
+			t.Errorf("Fibonacci(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestFibonacciAlgorithms(t *testing.T) {
+	algorithms := map[string]func(int) *big.Int{
+		"FibonacciSeries":       FibonacciSeries,
+		"FibonacciBlenkinsop":   FibonacciBlenkinsop,
+		"FibonacciTakahashi":    FibonacciTakahashi,
+		"FibonacciFastDoubling": FibonacciFastDoubling,
+		"FibonacciMatrix":       FibonacciMatrix,
+	}
+
+	for name, fn := range algorithms {
+		for _, n := range testSizes {
+			want := bruteForceFibonacci(n)
+			if got := fn(n); got.Cmp(want) != 0 {
+				t.Errorf("%s(%d) = %v, want %v", name, n, got, want)
+			}
+		}
+	}
+}
+
+func TestFibonacciLucas(t *testing.T) {
+	// L(n) = F(n-1) + F(n+1); bruteForceLucas starts from the n=0 base case
+	// (2, 1) instead of subtracting 1 from n=0.
+	l0, l1 := big.NewInt(2), big.NewInt(1)
+	bruteForceLucas := func(n int) *big.Int {
+		a, b := l0, l1
+		for i := 0; i < n; i++ {
+			a, b = b, new(big.Int).Add(a, b)
+		}
+		return a
+	}
+
+	for _, n := range testSizes {
+		f, l := FibonacciLucas(n)
+		if want := bruteForceFibonacci(n); f.Cmp(want) != 0 {
+			t.Errorf("FibonacciLucas(%d) f = %v, want %v", n, f, want)
+		}
+		if want := bruteForceLucas(n); l.Cmp(want) != 0 {
+			t.Errorf("FibonacciLucas(%d) l = %v, want %v", n, l, want)
+		}
+	}
+}
+
+func TestContextFibonacci(t *testing.T) {
+	c := NewContext()
+	dst := new(big.Int)
+
+	methods := map[string]func(int, *big.Int) *big.Int{
+		"Context.Fibonacci":             c.Fibonacci,
+		"Context.FibonacciSeries":       c.FibonacciSeries,
+		"Context.FibonacciBlenkinsop":   c.FibonacciBlenkinsop,
+		"Context.FibonacciTakahashi":    c.FibonacciTakahashi,
+		"Context.FibonacciFastDoubling": c.FibonacciFastDoubling,
+	}
+
+	for name, fn := range methods {
+		for _, n := range testSizes {
+			want := bruteForceFibonacci(n)
+			if got := fn(n, dst); got.Cmp(want) != 0 {
+				t.Errorf("%s(%d) = %v, want %v", name, n, got, want)
+			}
+		}
+	}
+}
+
+func TestContextPool(t *testing.T) {
+	c := GetContext()
+	defer PutContext(c)
+
+	dst := new(big.Int)
+	if got, want := c.Fibonacci(100, dst), bruteForceFibonacci(100); got.Cmp(want) != 0 {
+		t.Errorf("pooled Context.Fibonacci(100) = %v, want %v", got, want)
+	}
+}
+
+func TestRegisterOverridesMostRecent(t *testing.T) {
+	before := len(algorithms)
+	defer func() { algorithms = algorithms[:before] }()
+
+	want := big.NewInt(-1)
+	Register("test-override", func(int) *big.Int { return want }, 100, 100)
+
+	if got := Fibonacci(100); got.Cmp(want) != 0 {
+		t.Errorf("Fibonacci(100) after override = %v, want %v", got, want)
+	}
+
+	// The same override should reach Context.Fibonacci, falling back to
+	// calling Fn directly since "test-override" isn't a built-in name.
+	dst := new(big.Int)
+	c := NewContext()
+	if got := c.Fibonacci(100, dst); got.Cmp(want) != 0 {
+		t.Errorf("Context.Fibonacci(100) after override = %v, want %v", got, want)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	s := NewSequence()
+	for n := 0; n < 200; n++ {
+		want := bruteForceFibonacci(n)
+		if got := s.Next(); got.Cmp(want) != 0 {
+			t.Errorf("Sequence.Next() at n=%d = %v, want %v", n, got, want)
+		}
+	}
+
+	for _, n := range testSizes {
+		s.SeekTo(n)
+		fn, fn1 := s.Pair()
+		if want := bruteForceFibonacci(n); fn.Cmp(want) != 0 {
+			t.Errorf("Sequence.SeekTo(%d) Pair() fn = %v, want %v", n, fn, want)
+		}
+		if want := bruteForceFibonacci(n + 1); fn1.Cmp(want) != 0 {
+			t.Errorf("Sequence.SeekTo(%d) Pair() fn1 = %v, want %v", n, fn1, want)
+		}
+		if got, want := s.Next(), bruteForceFibonacci(n); got.Cmp(want) != 0 {
+			t.Errorf("Sequence.SeekTo(%d) then Next() = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestFibonacciMod(t *testing.T) {
+	moduli := []int64{1, 2, 7, 1000, 1009, 1000003}
+	for _, m := range moduli {
+		bm := big.NewInt(m)
+		for _, n := range testSizes {
+			want := new(big.Int).Mod(bruteForceFibonacci(n), bm)
+			if got := FibonacciMod(big.NewInt(int64(n)), bm); got.Cmp(want) != 0 {
+				t.Errorf("FibonacciMod(%d, %d) = %v, want %v", n, m, got, want)
+			}
+		}
+	}
+}
+
+func bruteForcePisanoPeriod(m int64) int64 {
+	if m == 1 {
+		return 1
+	}
+	a, b := int64(0), int64(1)
+	for i := int64(1); ; i++ {
+		a, b = b, (a+b)%m
+		if a == 0 && b == 1 {
+			return i
+		}
+	}
+}
+
+func TestPisanoPeriod(t *testing.T) {
+	for _, m := range []int64{1, 2, 5, 7, 10, 1009, 100003} {
+		want := bruteForcePisanoPeriod(m)
+		if got := PisanoPeriod(big.NewInt(m)); got.Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("PisanoPeriod(%d) = %v, want %v", m, got, want)
+		}
+	}
+}
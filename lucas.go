@@ -0,0 +1,25 @@
+package fibonacci
+
+import (
+	"math/big"
+)
+
+// fibLucas returns F(n) and the companion Lucas number L(n) together. It
+// reuses fibFastDoublingPair's (F(n), F(n+1)) and the identity
+// L(n) = F(n+1) + F(n-1) = 2*F(n+1) - F(n), so the pair costs one extra
+// shift-and-subtract over computing F(n) alone.
+func fibLucas(n int) (f, l *big.Int) {
+	f, fn1 := fibFastDoublingPair(n)
+
+	l = new(big.Int).Lsh(fn1, 1)
+	l.Sub(l, f)
+
+	return f, l
+}
+
+// FibonacciLucas returns F(n) and the companion Lucas number L(n) together,
+// so callers who need both (as fibTakahashi effectively computes internally)
+// can get them in one call instead of two.
+func FibonacciLucas(n int) (f, l *big.Int) {
+	return fibLucas(n)
+}
@@ -0,0 +1,65 @@
+package fibonacci
+
+import (
+	"math"
+	"math/big"
+)
+
+// Algorithm is a Fibonacci implementation registered for use over a range of
+// n, turning Fibonacci's algorithm selection into a data-driven dispatcher
+// instead of a hard-coded ladder of cases.
+type Algorithm struct {
+	Name                   string
+	Fn                     func(int) *big.Int
+	LowerBound, UpperBound int // Fn is used for n in [LowerBound, UpperBound]
+}
+
+// algorithms holds the registered algorithms in registration order;
+// algorithmFor searches it most-recently-registered first, so a later
+// Register call can override an earlier one.
+var algorithms []Algorithm
+
+// Register adds an algorithm to Fibonacci's dispatch table for use when n
+// falls in [lowerBound, upperBound]. Algorithms are tried most-recently-
+// registered first, so calling Register with bounds that overlap an earlier
+// registration overrides it for the n they share; the earlier registration
+// still applies outside the overlap. This lets users override the default
+// selection or plug in their own implementation. Register is not safe to
+// call concurrently with Fibonacci.
+func Register(name string, fn func(int) *big.Int, lowerBound, upperBound int) {
+	algorithms = append(algorithms, Algorithm{Name: name, Fn: fn, LowerBound: lowerBound, UpperBound: upperBound})
+}
+
+func init() {
+	// crossovers measured on 2013 MacBook Pro test system (2.7 GHz Intel Core
+	// i7, MacBookPro10,1); see Fibonacci's doc comment. fibFastDoubling is
+	// exported and registerable (see FibonacciFastDoubling) but is not part
+	// of the default ladder: benchmarked against fibTakahashi at n = 50000,
+	// 200000, and 1000000, fibTakahashi won at every size, so there is no
+	// measured n where fast doubling should take over. Register it yourself
+	// with Register("fastdoubling", FibonacciFastDoubling, lo, hi) if you
+	// measure a crossover on your own hardware.
+	Register("series", fibSeries, 0, 100)
+	Register("blenkinsop", fibBlenkinsop, 101, 5504)
+	Register("takahashi", fibTakahashi, 5505, math.MaxInt)
+}
+
+// algorithmFor returns the Fn of the most recently registered Algorithm whose
+// bounds contain n, falling back to the first registered Algorithm if every
+// registered range was overridden to exclude n.
+func algorithmFor(n int) func(int) *big.Int {
+	return algorithmEntryFor(n).Fn
+}
+
+// algorithmEntryFor is algorithmFor's underlying search, returning the whole
+// Algorithm (not just its Fn) so callers like (*Context).Fibonacci can
+// recognize a built-in by Name and dispatch to the matching scratch-reusing
+// Context method instead of calling Fn and copying the result.
+func algorithmEntryFor(n int) Algorithm {
+	for i := len(algorithms) - 1; i >= 0; i-- {
+		if a := algorithms[i]; n >= a.LowerBound && n <= a.UpperBound {
+			return a
+		}
+	}
+	return algorithms[0]
+}
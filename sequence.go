@@ -0,0 +1,56 @@
+package fibonacci
+
+import (
+	"math/big"
+)
+
+// Sequence produces consecutive Fibonacci numbers F(0), F(1), F(2), ... one
+// at a time. Each call to Next costs a single big.Int addition, so walking
+// the first N terms is O(N) additions rather than N independent calls to
+// Fibonacci, each of which redoes the work from scratch. A Sequence is not
+// safe for concurrent use.
+type Sequence struct {
+	n       int
+	a, b    *big.Int // F(n), F(n+1)
+	scratch *big.Int
+}
+
+// NewSequence returns a Sequence positioned so that the first call to Next
+// returns F(0).
+func NewSequence() *Sequence {
+	return &Sequence{
+		n:       0,
+		a:       big.NewInt(0),
+		b:       big.NewInt(1),
+		scratch: new(big.Int),
+	}
+}
+
+// Next returns F(n) for the sequence's current index n and advances the
+// sequence to n+1.
+func (s *Sequence) Next() *big.Int {
+	f := new(big.Int).Set(s.a)
+
+	s.scratch.Add(s.a, s.b)
+	s.a.Set(s.b)
+	s.b.Set(s.scratch)
+	s.n++
+
+	return f
+}
+
+// Pair returns copies of F(n) and F(n+1) for the sequence's current index n,
+// without advancing the sequence.
+func (s *Sequence) Pair() (fn, fn1 *big.Int) {
+	return new(big.Int).Set(s.a), new(big.Int).Set(s.b)
+}
+
+// SeekTo jumps the sequence forward (or backward) so that the next call to
+// Next returns F(n). It uses fast doubling to reach F(n) and F(n+1) directly
+// in O(log n) time rather than stepping through every intermediate index.
+func (s *Sequence) SeekTo(n int) {
+	a, b := fibFastDoublingPair(n)
+	s.n = n
+	s.a = a
+	s.b = b
+}